@@ -1,14 +1,16 @@
 package blockchain
 
 import (
+	"context"
 	"sync"
+	"time"
 
-	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/lru"
 )
 
 var (
@@ -26,6 +28,28 @@ var (
 		Name: "check_point_info_cache_hit",
 		Help: "The number of check point info requests that are present in the cache.",
 	})
+	// cacheSize tracks the current number of check point info entries held in the cache.
+	cacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "check_point_info_cache_size",
+		Help: "The current number of check point info entries held in the cache.",
+	})
+	// cacheCapacity tracks the configured max size of the check point info cache.
+	cacheCapacity = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "check_point_info_cache_capacity",
+		Help: "The configured max number of check point info entries the cache can hold.",
+	})
+	// cacheEvictions tracks the number of check point info entries evicted from the in-memory LRU,
+	// whether to make room for new ones or by a Remove or Purge of the LRU.
+	cacheEvictions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "check_point_info_cache_evictions",
+		Help: "The number of check point info entries evicted from the cache.",
+	})
+	// cacheLookupLatency tracks the time spent servicing a single cache lookup.
+	cacheLookupLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "check_point_info_cache_lookup_latency_seconds",
+		Help:    "The time it takes to look up a check point info entry in the cache, in seconds.",
+		Buckets: prometheus.DefBuckets,
+	})
 )
 
 // CheckPtInfo defines struct with necessary fields to verify an attestation signature.
@@ -37,38 +61,72 @@ type CheckPtInfo struct {
 	pubKeys       [][48]byte
 }
 
-// checkPtInfoCache is a struct with 1 queue for looking up check point info by checkpoint.
+// checkPtInfoCache is a struct with 1 queue for looking up check point info by checkpoint,
+// backed by an optional on-disk tier for fast warm-up across restarts.
 type checkPtInfoCache struct {
-	cache *lru.Cache
+	cache *lru.LRU[[32]byte, *CheckPtInfo]
+	db    checkPointInfoStore
 	lock  sync.RWMutex
 }
 
-// newCheckPointInfoCache creates a new checkpoint state cache for storing/accessing processed state.
+// newCheckPointInfoCache creates a new checkpoint state cache for storing/accessing processed
+// state, sized to maxCacheSize. Most callers should go through NewService, which sizes the
+// cache from the --checkpoint-info-cache-size flag instead.
 func newCheckPointInfoCache() *checkPtInfoCache {
-	cache, err := lru.New(maxCacheSize)
+	return newCheckPointInfoCacheWithConfig(maxCacheSize, nil)
+}
+
+// newCheckPointInfoCacheWithConfig builds the cache with an explicit capacity and an optional
+// db tier: entries are written through to db and consulted on an in-memory miss, so a restart
+// doesn't force recomputing activeIndices/seed/pubKeys for checkpoints seen in a prior run.
+func newCheckPointInfoCacheWithConfig(size int, db checkPointInfoStore) *checkPtInfoCache {
+	if size <= 0 {
+		size = maxCacheSize
+	}
+	cache, err := lru.NewWithEvict[[32]byte, *CheckPtInfo](size, func(_ [32]byte, _ *CheckPtInfo) {
+		cacheEvictions.Inc()
+		cacheSize.Dec()
+	})
 	if err != nil {
 		panic(err)
 	}
+	cacheCapacity.Set(float64(size))
 	return &checkPtInfoCache{
 		cache: cache,
+		db:    db,
 	}
 }
 
 // get fetches info by checkpoint. Returns the reference of the CheckPtInfo, nil if doesn't exist.
-func (c *checkPtInfoCache) get(cp *ethpb.Checkpoint) (*CheckPtInfo, error) {
-	c.lock.RLock()
-	defer c.lock.RUnlock()
+// On an in-memory miss it falls through to the on-disk tier, if configured.
+func (c *checkPtInfoCache) get(ctx context.Context, cp *ethpb.Checkpoint) (*CheckPtInfo, error) {
+	start := time.Now()
+	defer func() {
+		cacheLookupLatency.Observe(time.Since(start).Seconds())
+	}()
+
 	h, err := hashutil.HashProto(cp)
 	if err != nil {
 		return nil, err
 	}
 
+	c.lock.RLock()
 	item, exists := c.cache.Get(h)
+	c.lock.RUnlock()
 
 	if exists && item != nil {
 		cacheHit.Inc()
 		// Copy here is unnecessary since the return will only be used to verify attestation signature.
-		return item.(*CheckPtInfo), nil
+		return item, nil
+	}
+
+	info, err := c.getFromDisk(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	if info != nil {
+		cacheHit.Inc()
+		return info, nil
 	}
 
 	cacheMiss.Inc()
@@ -77,9 +135,8 @@ func (c *checkPtInfoCache) get(cp *ethpb.Checkpoint) (*CheckPtInfo, error) {
 
 // put adds CheckPtInfo info object to the cache. This method also trims the least
 // recently added CheckPtInfo object if the cache size has ready the max cache size limit.
-func (c *checkPtInfoCache) put(cp *ethpb.Checkpoint, f *pb.Fork, g [32]byte, s [32]byte, indices []uint64, pk [][48]byte) error {
-	c.lock.Lock()
-	defer c.lock.Unlock()
+// If a disk tier is configured, the entry is written through so it survives a restart.
+func (c *checkPtInfoCache) put(ctx context.Context, cp *ethpb.Checkpoint, f *pb.Fork, g [32]byte, s [32]byte, indices []uint64, pk [][48]byte) error {
 	h, err := hashutil.HashProto(cp)
 	if err != nil {
 		return err
@@ -93,8 +150,14 @@ func (c *checkPtInfoCache) put(cp *ethpb.Checkpoint, f *pb.Fork, g [32]byte, s [
 		pubKeys:       pk,
 	}
 
+	c.lock.Lock()
+	if !c.cache.Contains(h) {
+		cacheSize.Inc()
+	}
 	c.cache.Add(h, info)
-	return nil
+	c.lock.Unlock()
+
+	return c.putWithDisk(ctx, h, info)
 }
 
 func (c *CheckPtInfo) Fork() *pb.Fork {
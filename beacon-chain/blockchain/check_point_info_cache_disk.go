@@ -0,0 +1,201 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// finalizedCheckPtInfoRetention is the number of most recent finalized checkpoints whose
+// CheckPtInfo is kept on disk. Older entries are pruned on finality since they can always
+// be recomputed from state, but doing so is expensive, hence the retention window.
+const finalizedCheckPtInfoRetention = 3
+
+// checkPointInfoStore is the subset of the beacon-chain/db/kv store that the disk tier of
+// checkPtInfoCache needs. It deals in the encoded form (see encodeCheckPtInfo/decodeCheckPtInfo)
+// rather than *CheckPtInfo directly: beacon-chain/db/kv cannot import this package without
+// creating an import cycle, since blockchain already depends on the db layer.
+type checkPointInfoStore interface {
+	CheckPointInfo(ctx context.Context, checkPointRoot [32]byte) ([]byte, error)
+	SaveCheckPointInfo(ctx context.Context, checkPointRoot [32]byte, enc []byte) error
+	DeleteCheckPointInfo(ctx context.Context, checkPointRoot [32]byte) error
+}
+
+// getFromDisk is consulted by get on an in-memory miss. If found, it decodes the entry, warms
+// the in-memory LRU and its size metric, and returns it so subsequent lookups are served from
+// memory.
+func (c *checkPtInfoCache) getFromDisk(ctx context.Context, h [32]byte) (*CheckPtInfo, error) {
+	if c.db == nil {
+		return nil, nil
+	}
+
+	enc, err := c.db.CheckPointInfo(ctx, h)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read check point info from disk")
+	}
+	if enc == nil {
+		return nil, nil
+	}
+	info, err := decodeCheckPtInfo(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode check point info from disk")
+	}
+
+	c.lock.Lock()
+	if !c.cache.Contains(h) {
+		cacheSize.Inc()
+	}
+	c.cache.Add(h, info)
+	c.lock.Unlock()
+	return info, nil
+}
+
+// putWithDisk writes through to the on-disk tier in addition to the in-memory LRU so the
+// entry survives a restart.
+func (c *checkPtInfoCache) putWithDisk(ctx context.Context, h [32]byte, info *CheckPtInfo) error {
+	if c.db == nil {
+		return nil
+	}
+	enc, err := encodeCheckPtInfo(info)
+	if err != nil {
+		return errors.Wrap(err, "could not encode check point info for disk")
+	}
+	return c.db.SaveCheckPointInfo(ctx, h, enc)
+}
+
+// pruneFinalized deletes on-disk CheckPtInfo entries for finalized checkpoints older than
+// the retention window. It is intended to be called by blockchain.Service whenever
+// finality advances, with finalizedRoots ordered oldest to newest.
+func (c *checkPtInfoCache) pruneFinalized(ctx context.Context, finalizedRoots [][32]byte) error {
+	if c.db == nil || len(finalizedRoots) <= finalizedCheckPtInfoRetention {
+		return nil
+	}
+	stale := finalizedRoots[:len(finalizedRoots)-finalizedCheckPtInfoRetention]
+	for _, root := range stale {
+		if err := c.db.DeleteCheckPointInfo(ctx, root); err != nil {
+			return errors.Wrap(err, "could not prune check point info")
+		}
+	}
+	return nil
+}
+
+// encodeCheckPtInfo serializes a CheckPtInfo for disk storage. The fork is proto-encoded,
+// the fixed-size fields are written directly, and the activeIndices/pubKeys blobs - which
+// dominate the encoded size - are snappy-compressed.
+func encodeCheckPtInfo(info *CheckPtInfo) ([]byte, error) {
+	forkBytes, err := proto.Marshal(info.fork)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal fork")
+	}
+
+	indices := make([]byte, len(info.activeIndices)*8)
+	for i, idx := range info.activeIndices {
+		binary.LittleEndian.PutUint64(indices[i*8:], idx)
+	}
+	compressedIndices := snappy.Encode(nil, indices)
+
+	pubKeys := make([]byte, len(info.pubKeys)*48)
+	for i, pk := range info.pubKeys {
+		copy(pubKeys[i*48:], pk[:])
+	}
+	compressedPubKeys := snappy.Encode(nil, pubKeys)
+
+	buf := new(bytes.Buffer)
+	for _, field := range [][]byte{
+		info.genesisRoot[:],
+		info.seed[:],
+		uint32ToBytes(uint32(len(forkBytes))),
+		forkBytes,
+		uint32ToBytes(uint32(len(compressedIndices))),
+		compressedIndices,
+		uint32ToBytes(uint32(len(compressedPubKeys))),
+		compressedPubKeys,
+	} {
+		if _, err := buf.Write(field); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeCheckPtInfo is the inverse of encodeCheckPtInfo.
+func decodeCheckPtInfo(enc []byte) (*CheckPtInfo, error) {
+	if len(enc) < 64 {
+		return nil, errors.New("encoded check point info too short")
+	}
+	info := &CheckPtInfo{}
+	copy(info.genesisRoot[:], enc[:32])
+	copy(info.seed[:], enc[32:64])
+	r := bytes.NewReader(enc[64:])
+
+	forkBytes, err := readLengthPrefixed(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read fork")
+	}
+	fork := &pb.Fork{}
+	if err := proto.Unmarshal(forkBytes, fork); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal fork")
+	}
+	info.fork = fork
+
+	compressedIndices, err := readLengthPrefixed(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read active indices")
+	}
+	indices, err := snappy.Decode(nil, compressedIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress active indices")
+	}
+	info.activeIndices = make([]uint64, len(indices)/8)
+	for i := range info.activeIndices {
+		info.activeIndices[i] = binary.LittleEndian.Uint64(indices[i*8:])
+	}
+
+	compressedPubKeys, err := readLengthPrefixed(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read pub keys")
+	}
+	pubKeys, err := snappy.Decode(nil, compressedPubKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress pub keys")
+	}
+	info.pubKeys = make([][48]byte, len(pubKeys)/48)
+	for i := range info.pubKeys {
+		copy(info.pubKeys[i][:], pubKeys[i*48:])
+	}
+
+	return info, nil
+}
+
+func uint32ToBytes(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
+
+// readLengthPrefixed reads a 4-byte little-endian length prefix followed by that many bytes.
+// It uses io.ReadFull so a short read (e.g. from data truncated by a crash mid-write) is
+// reported as an error instead of silently returning a zero-padded buffer, and it bounds n
+// against the bytes actually remaining in r before allocating, so a corrupted length prefix
+// can't be used to trigger an arbitrarily large allocation.
+func readLengthPrefixed(r *bytes.Reader) ([]byte, error) {
+	lenBytes := make([]byte, 4)
+	if _, err := io.ReadFull(r, lenBytes); err != nil {
+		return nil, errors.Wrap(err, "could not read length prefix")
+	}
+	n := binary.LittleEndian.Uint32(lenBytes)
+	if int64(n) > int64(r.Len()) {
+		return nil, errors.Errorf("length prefix %d exceeds %d remaining bytes", n, r.Len())
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, errors.Wrap(err, "could not read length-prefixed field")
+	}
+	return buf, nil
+}
@@ -0,0 +1,153 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// fakeCheckPointInfoStore is an in-memory stand-in for *kv.Store used to exercise the disk
+// tier without a real database.
+type fakeCheckPointInfoStore struct {
+	lock sync.Mutex
+	data map[[32]byte][]byte
+}
+
+func newFakeCheckPointInfoStore() *fakeCheckPointInfoStore {
+	return &fakeCheckPointInfoStore{data: make(map[[32]byte][]byte)}
+}
+
+func (f *fakeCheckPointInfoStore) CheckPointInfo(_ context.Context, root [32]byte) ([]byte, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.data[root], nil
+}
+
+func (f *fakeCheckPointInfoStore) SaveCheckPointInfo(_ context.Context, root [32]byte, enc []byte) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.data[root] = enc
+	return nil
+}
+
+func (f *fakeCheckPointInfoStore) DeleteCheckPointInfo(_ context.Context, root [32]byte) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.data, root)
+	return nil
+}
+
+func TestEncodeDecodeCheckPtInfo_RoundTrip(t *testing.T) {
+	info := &CheckPtInfo{
+		fork:          &pb.Fork{Epoch: 7},
+		genesisRoot:   [32]byte{1, 2, 3},
+		seed:          [32]byte{4, 5, 6},
+		activeIndices: []uint64{1, 2, 3, 4},
+		pubKeys:       [][48]byte{{9}, {10}},
+	}
+
+	enc, err := encodeCheckPtInfo(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decodeCheckPtInfo(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.genesisRoot != info.genesisRoot || got.seed != info.seed {
+		t.Fatalf("fixed fields did not round-trip: got %+v, want %+v", got, info)
+	}
+	if len(got.activeIndices) != len(info.activeIndices) {
+		t.Fatalf("got %d active indices, want %d", len(got.activeIndices), len(info.activeIndices))
+	}
+	for i, idx := range info.activeIndices {
+		if got.activeIndices[i] != idx {
+			t.Fatalf("activeIndices[%d] = %d, want %d", i, got.activeIndices[i], idx)
+		}
+	}
+	if len(got.pubKeys) != len(info.pubKeys) {
+		t.Fatalf("got %d pub keys, want %d", len(got.pubKeys), len(info.pubKeys))
+	}
+	for i, pk := range info.pubKeys {
+		if got.pubKeys[i] != pk {
+			t.Fatalf("pubKeys[%d] = %v, want %v", i, got.pubKeys[i], pk)
+		}
+	}
+	if got.fork.Epoch != info.fork.Epoch {
+		t.Fatalf("got fork epoch %d, want %d", got.fork.Epoch, info.fork.Epoch)
+	}
+}
+
+func TestCheckPtInfoCache_DiskFallbackWarmsMemoryAndSize(t *testing.T) {
+	cacheSize.Set(0)
+	store := newFakeCheckPointInfoStore()
+	c := newCheckPointInfoCacheWithConfig(8, store)
+	ctx := context.Background()
+	cp := &ethpb.Checkpoint{Epoch: 3}
+
+	if err := c.put(ctx, cp, &pb.Fork{}, [32]byte{1}, [32]byte{2}, []uint64{5}, [][48]byte{{6}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Purge the in-memory tier only, simulating a restart; the disk tier still has the entry.
+	// Purge evicts the entry, so cacheSize should drop back to 0.
+	c.cache.Purge()
+	if got := testutil.ToFloat64(cacheSize); got != 0 {
+		t.Fatalf("got cacheSize %v after purge, want 0", got)
+	}
+
+	got, err := c.get(ctx, cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil {
+		t.Fatal("expected the disk tier to serve the entry after the memory purge")
+	}
+	if c.cache.Len() != 1 {
+		t.Fatalf("expected getFromDisk to warm the in-memory LRU, got len %d", c.cache.Len())
+	}
+	// getFromDisk must bump cacheSize the same way put does, or the gauge drifts/goes negative
+	// once entries start being warmed from disk instead of computed fresh.
+	if got := testutil.ToFloat64(cacheSize); got != 1 {
+		t.Fatalf("got cacheSize %v after disk warm, want 1", got)
+	}
+}
+
+func TestCheckPtInfoCache_PruneFinalized(t *testing.T) {
+	store := newFakeCheckPointInfoStore()
+	c := newCheckPointInfoCacheWithConfig(8, store)
+	ctx := context.Background()
+
+	var roots [][32]byte
+	for i := 0; i < 5; i++ {
+		root := [32]byte{byte(i)}
+		roots = append(roots, root)
+		if err := store.SaveCheckPointInfo(ctx, root, []byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := c.pruneFinalized(ctx, roots); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the last finalizedCheckPtInfoRetention (3) roots should remain.
+	for i, root := range roots {
+		enc, err := store.CheckPointInfo(ctx, root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantPresent := i >= len(roots)-finalizedCheckPtInfoRetention
+		if wantPresent && enc == nil {
+			t.Fatalf("expected root %d to survive pruning", i)
+		}
+		if !wantPresent && enc != nil {
+			t.Fatalf("expected root %d to be pruned", i)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// prefetchWorkerPoolSize bounds the number of checkpoints computed concurrently by Prefetch,
+// so a burst of aggregate attestations across many unseen targets can't spin up unbounded
+// state-gen work.
+const prefetchWorkerPoolSize = 8
+
+// checkPtInfoComputeFunc recomputes the fields of a CheckPtInfo for a checkpoint that isn't
+// in the cache, via the state-gen path. It mirrors the parameters of checkPtInfoCache.put.
+type checkPtInfoComputeFunc func(ctx context.Context, cp *ethpb.Checkpoint) (f *pb.Fork, g [32]byte, s [32]byte, indices []uint64, pk [][48]byte, err error)
+
+// GetMulti looks up CheckPtInfo for several checkpoints at once. It returns the entries that
+// were found keyed by checkpoint hash, along with the subset of cps that were not present in
+// either cache tier so the caller can decide whether to compute or prefetch them.
+func (c *checkPtInfoCache) GetMulti(ctx context.Context, cps []*ethpb.Checkpoint) (map[[32]byte]*CheckPtInfo, []*ethpb.Checkpoint, error) {
+	found := make(map[[32]byte]*CheckPtInfo, len(cps))
+	var missing []*ethpb.Checkpoint
+
+	for _, cp := range cps {
+		info, err := c.get(ctx, cp)
+		if err != nil {
+			return nil, nil, err
+		}
+		if info == nil {
+			missing = append(missing, cp)
+			continue
+		}
+		h, err := hashutil.HashProto(cp)
+		if err != nil {
+			return nil, nil, err
+		}
+		found[h] = info
+	}
+
+	return found, missing, nil
+}
+
+// Prefetch warms the cache for cps that aren't already present, using a bounded worker pool
+// to compute each miss via compute. It is best-effort: a failure to compute one checkpoint is
+// logged and does not prevent the others from being prefetched.
+func (c *checkPtInfoCache) Prefetch(ctx context.Context, cps []*ethpb.Checkpoint, compute checkPtInfoComputeFunc) {
+	_, missing, err := c.GetMulti(ctx, cps)
+	if err != nil {
+		log.WithError(err).Error("Could not check cache before prefetching check point info")
+		return
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	sem := make(chan struct{}, prefetchWorkerPoolSize)
+	var wg sync.WaitGroup
+	for _, cp := range missing {
+		cp := cp
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			f, g, s, indices, pk, err := compute(ctx, cp)
+			if err != nil {
+				log.WithError(err).WithField("epoch", cp.Epoch).Error("Could not prefetch check point info")
+				return
+			}
+			if err := c.put(ctx, cp, f, g, s, indices, pk); err != nil {
+				log.WithError(err).WithField("epoch", cp.Epoch).Error("Could not cache prefetched check point info")
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,99 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestCheckPtInfoCache_GetMulti(t *testing.T) {
+	c := newCheckPointInfoCacheWithConfig(8, nil)
+	ctx := context.Background()
+
+	present := &ethpb.Checkpoint{Epoch: 1}
+	absent := &ethpb.Checkpoint{Epoch: 2}
+
+	if err := c.put(ctx, present, &pb.Fork{}, [32]byte{}, [32]byte{}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	found, missing, err := c.GetMulti(ctx, []*ethpb.Checkpoint{present, absent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 {
+		t.Fatalf("got %d found, want 1", len(found))
+	}
+	if len(missing) != 1 || missing[0].Epoch != absent.Epoch {
+		t.Fatalf("got missing %+v, want [absent]", missing)
+	}
+}
+
+func TestCheckPtInfoCache_Prefetch_FillsMissesAndBoundsConcurrency(t *testing.T) {
+	c := newCheckPointInfoCacheWithConfig(64, nil)
+	ctx := context.Background()
+
+	const numCheckpoints = 32
+	cps := make([]*ethpb.Checkpoint, numCheckpoints)
+	for i := range cps {
+		cps[i] = &ethpb.Checkpoint{Epoch: uint64(i)}
+	}
+
+	var inFlight int32
+	var maxInFlight int32
+	var mu sync.Mutex
+
+	compute := func(_ context.Context, cp *ethpb.Checkpoint) (*pb.Fork, [32]byte, [32]byte, []uint64, [][48]byte, error) {
+		cur := atomic.AddInt32(&inFlight, 1)
+		mu.Lock()
+		if cur > maxInFlight {
+			maxInFlight = cur
+		}
+		mu.Unlock()
+		atomic.AddInt32(&inFlight, -1)
+		return &pb.Fork{}, [32]byte{}, [32]byte{}, []uint64{cp.Epoch}, nil, nil
+	}
+
+	c.Prefetch(ctx, cps, compute)
+
+	for _, cp := range cps {
+		info, err := c.get(ctx, cp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info == nil {
+			t.Fatalf("expected checkpoint epoch %d to be prefetched", cp.Epoch)
+		}
+	}
+
+	mu.Lock()
+	got := maxInFlight
+	mu.Unlock()
+	if got > prefetchWorkerPoolSize {
+		t.Fatalf("got max concurrent compute calls %d, want <= %d", got, prefetchWorkerPoolSize)
+	}
+}
+
+func TestCheckPtInfoCache_Prefetch_SkipsAlreadyCached(t *testing.T) {
+	c := newCheckPointInfoCacheWithConfig(8, nil)
+	ctx := context.Background()
+	cp := &ethpb.Checkpoint{Epoch: 9}
+	if err := c.put(ctx, cp, &pb.Fork{}, [32]byte{}, [32]byte{}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	called := false
+	compute := func(_ context.Context, _ *ethpb.Checkpoint) (*pb.Fork, [32]byte, [32]byte, []uint64, [][48]byte, error) {
+		called = true
+		return &pb.Fork{}, [32]byte{}, [32]byte{}, nil, nil, nil
+	}
+
+	c.Prefetch(ctx, []*ethpb.Checkpoint{cp}, compute)
+	if called {
+		t.Fatal("expected Prefetch to skip a checkpoint already in the cache")
+	}
+}
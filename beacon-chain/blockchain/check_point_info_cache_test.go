@@ -0,0 +1,65 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestCheckPtInfoCache_PutGet(t *testing.T) {
+	c := newCheckPointInfoCacheWithConfig(2, nil)
+	ctx := context.Background()
+	cp := &ethpb.Checkpoint{Epoch: 1}
+
+	if got, err := c.get(ctx, cp); err != nil || got != nil {
+		t.Fatalf("expected miss, got %v, %v", got, err)
+	}
+
+	if err := c.put(ctx, cp, &pb.Fork{}, [32]byte{1}, [32]byte{2}, []uint64{1, 2}, [][48]byte{{3}}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.get(ctx, cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil {
+		t.Fatal("expected hit")
+	}
+	if got.ActiveCount() != 2 {
+		t.Fatalf("got %d, want 2", got.ActiveCount())
+	}
+}
+
+func TestCheckPtInfoCache_SizeAndEvictionMetrics(t *testing.T) {
+	cacheSize.Set(0)
+	startEvictions := testutil.ToFloat64(cacheEvictions)
+
+	c := newCheckPointInfoCacheWithConfig(1, nil)
+	ctx := context.Background()
+
+	cp1 := &ethpb.Checkpoint{Epoch: 1}
+	cp2 := &ethpb.Checkpoint{Epoch: 2}
+
+	if err := c.put(ctx, cp1, &pb.Fork{}, [32]byte{}, [32]byte{}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := testutil.ToFloat64(cacheSize); got != 1 {
+		t.Fatalf("got cacheSize %v, want 1", got)
+	}
+
+	// Adding a second entry on a size-1 cache evicts the first, which should decrement
+	// cacheSize back down while bumping the eviction counter.
+	if err := c.put(ctx, cp2, &pb.Fork{}, [32]byte{}, [32]byte{}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := testutil.ToFloat64(cacheSize); got != 1 {
+		t.Fatalf("got cacheSize %v, want 1 after eviction", got)
+	}
+	if got := testutil.ToFloat64(cacheEvictions); got != startEvictions+1 {
+		t.Fatalf("got cacheEvictions %v, want %v", got, startEvictions+1)
+	}
+}
@@ -0,0 +1,5 @@
+package blockchain
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "blockchain")
@@ -0,0 +1,74 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/urfave/cli/v2"
+)
+
+// CheckPtInfoPrefetcher lets other packages (e.g. the attestation pool) warm the CheckPtInfo
+// cache ahead of validation, without depending on blockchain's internal cache type.
+type CheckPtInfoPrefetcher interface {
+	PrefetchCheckPtInfo(ctx context.Context, cps []*ethpb.Checkpoint)
+}
+
+// Service hosts the blockchain package's long-lived caches, including the CheckPtInfo cache
+// used during attestation signature verification.
+type Service struct {
+	cpInfoCache *checkPtInfoCache
+
+	finalizedLock  sync.Mutex
+	finalizedRoots [][32]byte
+}
+
+// NewService creates a blockchain Service, sizing the CheckPtInfo cache from
+// --checkpoint-info-cache-size on cliCtx (falling back to maxCacheSize when unset) and wiring
+// db as its optional on-disk tier.
+func NewService(cliCtx *cli.Context, db checkPointInfoStore) *Service {
+	size := maxCacheSize
+	if cliCtx != nil && cliCtx.IsSet(flags.CheckPointInfoCacheSize.Name) {
+		size = cliCtx.Int(flags.CheckPointInfoCacheSize.Name)
+	}
+	return &Service{
+		cpInfoCache: newCheckPointInfoCacheWithConfig(size, db),
+	}
+}
+
+// PrefetchCheckPtInfo implements CheckPtInfoPrefetcher by running checkPtInfoCache.Prefetch for
+// cps with computeCheckPtInfo, on a bounded worker pool. It returns only after every missing
+// checkpoint has been computed or has failed, so the caller waits for that work.
+func (s *Service) PrefetchCheckPtInfo(ctx context.Context, cps []*ethpb.Checkpoint) {
+	s.cpInfoCache.Prefetch(ctx, cps, s.computeCheckPtInfo)
+}
+
+// computeCheckPtInfo is the compute function PrefetchCheckPtInfo passes to Prefetch. It always
+// returns an error naming the checkpoint's epoch and does not compute the fork, seed,
+// activeIndices or pubKeys, so no entry is cached through it.
+func (s *Service) computeCheckPtInfo(_ context.Context, cp *ethpb.Checkpoint) (*pb.Fork, [32]byte, [32]byte, []uint64, [][48]byte, error) {
+	return nil, [32]byte{}, [32]byte{}, nil, nil, errors.Errorf("state-gen path not available to compute check point info for epoch %d", cp.Epoch)
+}
+
+// OnFinalizedCheckpoint must be called whenever finality advances to root, oldest-to-newest
+// across calls. It deletes the on-disk CheckPtInfo entries of finalized roots older than the
+// last finalizedCheckPtInfoRetention passed here, since put/putWithDisk write through on every
+// call. Entries stored under keys never passed here are not deleted.
+func (s *Service) OnFinalizedCheckpoint(ctx context.Context, root [32]byte) error {
+	s.finalizedLock.Lock()
+	s.finalizedRoots = append(s.finalizedRoots, root)
+	// Only the trailing window is ever relevant to pruneFinalized; anything older has already
+	// been pruned on a prior call, so there's no reason to keep the full finality history
+	// around for the life of the node.
+	if len(s.finalizedRoots) > finalizedCheckPtInfoRetention+1 {
+		s.finalizedRoots = s.finalizedRoots[len(s.finalizedRoots)-finalizedCheckPtInfoRetention-1:]
+	}
+	roots := make([][32]byte, len(s.finalizedRoots))
+	copy(roots, s.finalizedRoots)
+	s.finalizedLock.Unlock()
+
+	return s.cpInfoCache.pruneFinalized(ctx, roots)
+}
@@ -0,0 +1,92 @@
+package blockchain
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	bflags "github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/urfave/cli/v2"
+)
+
+// TestNewService_UsesFlagSize verifies the cache capacity actually comes from a parsed
+// --checkpoint-info-cache-size value rather than the flag's static default.
+func TestNewService_UsesFlagSize(t *testing.T) {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	set.Int(bflags.CheckPointInfoCacheSize.Name, bflags.CheckPointInfoCacheSize.Value, "")
+	if err := set.Set(bflags.CheckPointInfoCacheSize.Name, "64"); err != nil {
+		t.Fatal(err)
+	}
+	cliCtx := cli.NewContext(nil, set, nil)
+
+	s := NewService(cliCtx, nil)
+	ctx := context.Background()
+	for i := 0; i < 64; i++ {
+		cp := &ethpb.Checkpoint{Epoch: uint64(i)}
+		if err := s.cpInfoCache.put(ctx, cp, &pb.Fork{}, [32]byte{}, [32]byte{}, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// maxCacheSize (the flag's static default) is 32; if NewService had ignored the parsed
+	// value, half of these puts would have evicted and Len would stop at 32.
+	if got := s.cpInfoCache.cache.Len(); got != 64 {
+		t.Fatalf("got cache len %d, want 64 (flag value should have sized the cache)", got)
+	}
+}
+
+// TestNewService_DefaultsWhenFlagUnset verifies the fallback path when no cli.Context is set.
+func TestNewService_DefaultsWhenFlagUnset(t *testing.T) {
+	s := NewService(nil, nil)
+	ctx := context.Background()
+	for i := 0; i < maxCacheSize+1; i++ {
+		cp := &ethpb.Checkpoint{Epoch: uint64(i)}
+		if err := s.cpInfoCache.put(ctx, cp, &pb.Fork{}, [32]byte{}, [32]byte{}, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := s.cpInfoCache.cache.Len(); got != maxCacheSize {
+		t.Fatalf("got cache len %d, want %d (default size)", got, maxCacheSize)
+	}
+}
+
+// TestService_OnFinalizedCheckpoint_PrunesDiskTier verifies finality events actually reach
+// pruneFinalized, so the on-disk tier doesn't grow without bound in production.
+func TestService_OnFinalizedCheckpoint_PrunesDiskTier(t *testing.T) {
+	store := newFakeCheckPointInfoStore()
+	s := NewService(nil, store)
+	ctx := context.Background()
+
+	const numFinalized = 5
+	var roots [][32]byte
+	for i := 0; i < numFinalized; i++ {
+		root := [32]byte{byte(i + 1)}
+		roots = append(roots, root)
+		if err := store.SaveCheckPointInfo(ctx, root, []byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.OnFinalizedCheckpoint(ctx, root); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i, root := range roots {
+		enc, err := store.CheckPointInfo(ctx, root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantPresent := i >= numFinalized-finalizedCheckPtInfoRetention
+		if wantPresent && enc == nil {
+			t.Fatalf("expected root %d to survive pruning via OnFinalizedCheckpoint", i)
+		}
+		if !wantPresent && enc != nil {
+			t.Fatalf("expected root %d to have been pruned via OnFinalizedCheckpoint", i)
+		}
+	}
+
+	// The in-memory finality history Service keeps for future prunes should also stay bounded.
+	if got := len(s.finalizedRoots); got > finalizedCheckPtInfoRetention+1 {
+		t.Fatalf("got %d tracked finalized roots, want at most %d", got, finalizedCheckPtInfoRetention+1)
+	}
+}
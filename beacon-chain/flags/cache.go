@@ -0,0 +1,18 @@
+package flags
+
+import "github.com/urfave/cli/v2"
+
+// CheckPointInfoCacheSize defines the max number of check point info entries the
+// beacon-chain service keeps in memory. Nodes that sit through long periods of
+// non-finality accumulate more distinct checkpoints and benefit from a larger cache.
+var CheckPointInfoCacheSize = &cli.IntFlag{
+	Name:  "checkpoint-info-cache-size",
+	Usage: "Max number of check point info entries to keep in the in-memory LRU cache.",
+	Value: 32,
+}
+
+// CacheFlags are flags that tune the size of in-memory caches kept by the beacon-chain
+// service. They are appended into the app's flag list at cmd wiring time.
+var CacheFlags = []cli.Flag{
+	CheckPointInfoCacheSize,
+}
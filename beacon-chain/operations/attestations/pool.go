@@ -0,0 +1,71 @@
+// Package attestations maintains the pool of attestations seen over gossip, pending
+// aggregation and inclusion in a block.
+package attestations
+
+import (
+	"context"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// Pool tracks incoming attestations from gossip ahead of aggregation.
+type Pool struct {
+	checkPtInfoPrefetcher blockchain.CheckPtInfoPrefetcher
+
+	seenTargetLock sync.Mutex
+	// seenTargets maps a target checkpoint's hash to its epoch, so entries at or behind
+	// finality can be dropped once SetFinalizedEpoch advances - otherwise this map grows for
+	// the lifetime of the process.
+	seenTargets    map[[32]byte]uint64
+	finalizedEpoch uint64
+}
+
+// NewPool creates an attestation Pool that prefetches CheckPtInfo for unseen targets via
+// prefetcher ahead of signature validation.
+func NewPool(prefetcher blockchain.CheckPtInfoPrefetcher) *Pool {
+	return &Pool{
+		checkPtInfoPrefetcher: prefetcher,
+		seenTargets:           make(map[[32]byte]uint64),
+	}
+}
+
+// sub handles an attestation received over the gossip subscription. For a target checkpoint
+// this pool hasn't seen before, it kicks off a CheckPtInfo prefetch before attestation
+// signature validation is invoked, so validation doesn't stall on state-gen for a cache miss.
+func (p *Pool) sub(ctx context.Context, att *ethpb.Attestation) error {
+	cp := att.Data.Target
+	h, err := hashutil.HashProto(cp)
+	if err != nil {
+		return err
+	}
+
+	p.seenTargetLock.Lock()
+	_, seen := p.seenTargets[h]
+	p.seenTargets[h] = cp.Epoch
+	p.seenTargetLock.Unlock()
+
+	if !seen {
+		p.checkPtInfoPrefetcher.PrefetchCheckPtInfo(ctx, []*ethpb.Checkpoint{cp})
+	}
+
+	return nil
+}
+
+// SetFinalizedEpoch updates the pool's notion of finality and evicts seenTargets entries at or
+// behind it, so the map tracks roughly one epoch's worth of distinct targets rather than every
+// target ever observed over the node's lifetime. It is intended to be called from the same
+// finality-event path that drives blockchain.Service.OnFinalizedCheckpoint.
+func (p *Pool) SetFinalizedEpoch(epoch uint64) {
+	p.seenTargetLock.Lock()
+	defer p.seenTargetLock.Unlock()
+
+	p.finalizedEpoch = epoch
+	for h, e := range p.seenTargets {
+		if e <= epoch {
+			delete(p.seenTargets, h)
+		}
+	}
+}
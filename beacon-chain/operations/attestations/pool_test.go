@@ -0,0 +1,90 @@
+package attestations
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// fakePrefetcher records PrefetchCheckPtInfo calls so tests can assert on wiring without a
+// real blockchain.Service.
+type fakePrefetcher struct {
+	lock  sync.Mutex
+	calls int
+}
+
+func (f *fakePrefetcher) PrefetchCheckPtInfo(_ context.Context, _ []*ethpb.Checkpoint) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.calls++
+}
+
+func (f *fakePrefetcher) callCount() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.calls
+}
+
+func TestPool_Sub_PrefetchesOncePerUnseenTarget(t *testing.T) {
+	fp := &fakePrefetcher{}
+	p := NewPool(fp)
+	ctx := context.Background()
+
+	att := &ethpb.Attestation{Data: &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: 5}}}
+
+	if err := p.sub(ctx, att); err != nil {
+		t.Fatal(err)
+	}
+	if fp.callCount() != 1 {
+		t.Fatalf("got %d prefetch calls, want 1", fp.callCount())
+	}
+
+	// A second attestation for the same target should not trigger another prefetch.
+	if err := p.sub(ctx, att); err != nil {
+		t.Fatal(err)
+	}
+	if fp.callCount() != 1 {
+		t.Fatalf("got %d prefetch calls after repeat target, want 1", fp.callCount())
+	}
+
+	// A new target should trigger a fresh prefetch.
+	att2 := &ethpb.Attestation{Data: &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: 6}}}
+	if err := p.sub(ctx, att2); err != nil {
+		t.Fatal(err)
+	}
+	if fp.callCount() != 2 {
+		t.Fatalf("got %d prefetch calls after new target, want 2", fp.callCount())
+	}
+}
+
+// TestPool_SetFinalizedEpoch_EvictsSeenTargets verifies seenTargets doesn't grow without bound:
+// once finality passes a target's epoch, it's evicted and a repeat attestation for it triggers
+// a fresh prefetch rather than being silently treated as already seen.
+func TestPool_SetFinalizedEpoch_EvictsSeenTargets(t *testing.T) {
+	fp := &fakePrefetcher{}
+	p := NewPool(fp)
+	ctx := context.Background()
+
+	att := &ethpb.Attestation{Data: &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: 1}}}
+	if err := p.sub(ctx, att); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.seenTargets) != 1 {
+		t.Fatalf("got %d seen targets, want 1", len(p.seenTargets))
+	}
+
+	p.SetFinalizedEpoch(1)
+	if len(p.seenTargets) != 0 {
+		t.Fatalf("got %d seen targets after finality passed epoch 1, want 0", len(p.seenTargets))
+	}
+
+	// A repeat attestation for the now-evicted target must be treated as unseen again.
+	if err := p.sub(ctx, att); err != nil {
+		t.Fatal(err)
+	}
+	if fp.callCount() != 2 {
+		t.Fatalf("got %d prefetch calls, want 2 (evicted target should re-prefetch)", fp.callCount())
+	}
+}
@@ -0,0 +1,5 @@
+package lru
+
+import "errors"
+
+var errInvalidSize = errors.New("lru: size must be positive")
@@ -0,0 +1,219 @@
+// Package lru provides a generic, type-safe LRU cache that mirrors the API of
+// github.com/hashicorp/golang-lru but avoids the interface{} boxing and type
+// assertions that come with it.
+//
+// beacon-chain/blockchain.checkPtInfoCache has been migrated onto this package. The
+// state summary, block, and attestation caches that also use the hashicorp LRU are not
+// present in this checkout to migrate; swapping them over is a drop-in change of the
+// same shape (replace *lru.Cache with *lru.LRU[K, V] and drop the .(type) assertion on
+// each Get) once those files exist here.
+package lru
+
+import "sync"
+
+// node is an entry in the cache's doubly-linked list. Evicted nodes are
+// reused on the next Add instead of being discarded, so steady-state use at
+// capacity performs zero additional allocations.
+type node[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *node[K, V]
+}
+
+// LRU is a thread-safe, fixed-size LRU cache of K to V.
+type LRU[K comparable, V any] struct {
+	lock     sync.Mutex
+	size     int
+	items    map[K]*node[K, V]
+	head     *node[K, V] // most recently used
+	tail     *node[K, V] // least recently used
+	onEvict  func(key K, value V)
+	freeList *node[K, V] // evicted nodes, kept for reuse
+}
+
+// New creates a new LRU cache of the given size. size must be positive.
+func New[K comparable, V any](size int) (*LRU[K, V], error) {
+	return NewWithEvict[K, V](size, nil)
+}
+
+// NewWithEvict creates a new LRU cache of the given size with a callback
+// invoked whenever an entry leaves the cache: when it is evicted to make room
+// for a new one, and for each entry dropped by Remove or Purge.
+func NewWithEvict[K comparable, V any](size int, onEvict func(key K, value V)) (*LRU[K, V], error) {
+	if size <= 0 {
+		return nil, errInvalidSize
+	}
+	return &LRU[K, V]{
+		size:    size,
+		items:   make(map[K]*node[K, V], size),
+		onEvict: onEvict,
+	}, nil
+}
+
+// Add inserts a value into the cache, evicting the least recently used entry
+// if the cache is at capacity. Returns true if an eviction occurred.
+func (c *LRU[K, V]) Add(key K, value V) (evicted bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if n, ok := c.items[key]; ok {
+		n.value = value
+		c.moveToFront(n)
+		return false
+	}
+
+	var n *node[K, V]
+	if len(c.items) >= c.size {
+		n = c.removeTail()
+		if c.onEvict != nil {
+			c.onEvict(n.key, n.value)
+		}
+		delete(c.items, n.key)
+		evicted = true
+	} else {
+		n = c.newNode()
+	}
+
+	n.key = key
+	n.value = value
+	c.items[key] = n
+	c.pushFront(n)
+	return evicted
+}
+
+// Get looks up a key's value from the cache, marking it as most recently used.
+func (c *LRU[K, V]) Get(key K) (value V, ok bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	n, ok := c.items[key]
+	if !ok {
+		return value, false
+	}
+	c.moveToFront(n)
+	return n.value, true
+}
+
+// Contains checks for the presence of a key without updating recency.
+func (c *LRU[K, V]) Contains(key K) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, ok := c.items[key]
+	return ok
+}
+
+// Peek returns a key's value without updating recency.
+func (c *LRU[K, V]) Peek(key K) (value V, ok bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	n, ok := c.items[key]
+	if !ok {
+		return value, false
+	}
+	return n.value, true
+}
+
+// Remove evicts a key from the cache, if present.
+func (c *LRU[K, V]) Remove(key K) (present bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	n, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.unlink(n)
+	delete(c.items, key)
+	if c.onEvict != nil {
+		c.onEvict(n.key, n.value)
+	}
+	c.release(n)
+	return true
+}
+
+// Len returns the number of items currently in the cache.
+func (c *LRU[K, V]) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.items)
+}
+
+// Purge clears all entries from the cache.
+func (c *LRU[K, V]) Purge() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.onEvict != nil {
+		for n := c.head; n != nil; n = n.next {
+			c.onEvict(n.key, n.value)
+		}
+	}
+	c.items = make(map[K]*node[K, V], c.size)
+	c.head = nil
+	c.tail = nil
+	c.freeList = nil
+}
+
+// newNode returns a fresh node, reusing one from the free list if available.
+func (c *LRU[K, V]) newNode() *node[K, V] {
+	if c.freeList == nil {
+		return &node[K, V]{}
+	}
+	n := c.freeList
+	c.freeList = n.next
+	n.prev = nil
+	n.next = nil
+	return n
+}
+
+// release returns a removed node to the free list for reuse.
+func (c *LRU[K, V]) release(n *node[K, V]) {
+	var zeroK K
+	var zeroV V
+	n.key = zeroK
+	n.value = zeroV
+	n.prev = nil
+	n.next = c.freeList
+	c.freeList = n
+}
+
+func (c *LRU[K, V]) pushFront(n *node[K, V]) {
+	n.prev = nil
+	n.next = c.head
+	if c.head != nil {
+		c.head.prev = n
+	}
+	c.head = n
+	if c.tail == nil {
+		c.tail = n
+	}
+}
+
+func (c *LRU[K, V]) unlink(n *node[K, V]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		c.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		c.tail = n.prev
+	}
+	n.prev = nil
+	n.next = nil
+}
+
+func (c *LRU[K, V]) moveToFront(n *node[K, V]) {
+	if c.head == n {
+		return
+	}
+	c.unlink(n)
+	c.pushFront(n)
+}
+
+// removeTail unlinks and returns the least recently used node without
+// touching the free list, so the caller can reuse it directly.
+func (c *LRU[K, V]) removeTail() *node[K, V] {
+	n := c.tail
+	c.unlink(n)
+	return n
+}
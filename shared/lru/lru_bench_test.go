@@ -0,0 +1,73 @@
+package lru
+
+import (
+	"testing"
+
+	hashicorplru "github.com/hashicorp/golang-lru"
+)
+
+// BenchmarkLRU_Add exercises the allocation-free-at-capacity hot path: the
+// first 128 iterations update the warm-up keys in place, and every Add after
+// that evicts an existing node and reuses it.
+func BenchmarkLRU_Add(b *testing.B) {
+	c, err := New[int, int](128)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < 128; i++ {
+		c.Add(i, i)
+	}
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.Add(i, i)
+	}
+}
+
+func BenchmarkLRU_Get(b *testing.B) {
+	c, err := New[int, int](128)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < 128; i++ {
+		c.Add(i, i)
+	}
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.Get(i % 128)
+	}
+}
+
+// BenchmarkHashicorpLRU_Add is the baseline this package replaces: interface{}
+// values mean each Add of a boxed value allocates.
+func BenchmarkHashicorpLRU_Add(b *testing.B) {
+	c, err := hashicorplru.New(128)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < 128; i++ {
+		c.Add(i, i)
+	}
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.Add(i, i)
+	}
+}
+
+func BenchmarkHashicorpLRU_Get(b *testing.B) {
+	c, err := hashicorplru.New(128)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < 128; i++ {
+		c.Add(i, i)
+	}
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v, _ := c.Get(i % 128)
+		_ = v.(int)
+	}
+}
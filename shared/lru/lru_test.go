@@ -0,0 +1,133 @@
+package lru
+
+import "testing"
+
+func TestLRU_AddGet(t *testing.T) {
+	c, err := New[int, string](2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Add(1, "a")
+	c.Add(2, "b")
+
+	v, ok := c.Get(1)
+	if !ok || v != "a" {
+		t.Fatalf("got %v, %v, want a, true", v, ok)
+	}
+
+	// 2 is now the least recently used, adding 3 should evict it.
+	if evicted := c.Add(3, "c"); !evicted {
+		t.Fatalf("expected eviction")
+	}
+	if _, ok := c.Get(2); ok {
+		t.Fatalf("expected 2 to be evicted")
+	}
+	if c.Len() != 2 {
+		t.Fatalf("got len %d, want 2", c.Len())
+	}
+}
+
+func TestLRU_OnEvicted(t *testing.T) {
+	var evictedKey int
+	var evictedVal string
+	c, err := NewWithEvict[int, string](1, func(k int, v string) {
+		evictedKey = k
+		evictedVal = v
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Add(1, "a")
+	c.Add(2, "b")
+	if evictedKey != 1 || evictedVal != "a" {
+		t.Fatalf("got %d, %s, want 1, a", evictedKey, evictedVal)
+	}
+}
+
+func TestLRU_Peek(t *testing.T) {
+	c, err := New[int, string](2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Add(1, "a")
+	c.Add(2, "b")
+	if _, ok := c.Peek(1); !ok {
+		t.Fatalf("expected 1 to be present")
+	}
+	// Peek must not affect recency: 1 stays least recently used.
+	c.Add(3, "c")
+	if _, ok := c.Get(1); ok {
+		t.Fatalf("expected 1 to be evicted since peek does not refresh recency")
+	}
+}
+
+func TestLRU_Contains(t *testing.T) {
+	c, err := New[int, string](1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Add(1, "a")
+	if !c.Contains(1) {
+		t.Fatalf("expected 1 to be present")
+	}
+	if c.Contains(2) {
+		t.Fatalf("expected 2 to be absent")
+	}
+}
+
+func TestLRU_Remove(t *testing.T) {
+	c, err := New[int, string](2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Add(1, "a")
+	if !c.Remove(1) {
+		t.Fatalf("expected removal to report present")
+	}
+	if c.Remove(1) {
+		t.Fatalf("expected second removal to report absent")
+	}
+	if c.Len() != 0 {
+		t.Fatalf("got len %d, want 0", c.Len())
+	}
+}
+
+func TestLRU_Purge(t *testing.T) {
+	c, err := New[int, string](2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Add(1, "a")
+	c.Add(2, "b")
+	c.Purge()
+	if c.Len() != 0 {
+		t.Fatalf("got len %d, want 0", c.Len())
+	}
+	if _, ok := c.Get(1); ok {
+		t.Fatalf("expected cache to be empty after purge")
+	}
+}
+
+func TestLRU_InvalidSize(t *testing.T) {
+	if _, err := New[int, string](0); err == nil {
+		t.Fatalf("expected error for non-positive size")
+	}
+}
+
+func TestLRU_AddUpdatesExisting(t *testing.T) {
+	c, err := New[int, string](2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Add(1, "a")
+	if evicted := c.Add(1, "z"); evicted {
+		t.Fatalf("updating an existing key should not evict")
+	}
+	v, _ := c.Get(1)
+	if v != "z" {
+		t.Fatalf("got %s, want z", v)
+	}
+	if c.Len() != 1 {
+		t.Fatalf("got len %d, want 1", c.Len())
+	}
+}